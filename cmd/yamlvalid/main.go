@@ -0,0 +1,82 @@
+// Command yamlvalid validates Kubernetes manifests (Pod, Deployment,
+// Service, ConfigMap, Job, CronJob; one or more documents per file) against
+// the rules implemented in pkg/validate.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/magomedmertens-lab/praktikum/pkg/validate"
+)
+
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "serve" {
+		runServe(os.Args[2:])
+		return
+	}
+
+	strict := flag.Bool("strict", false, "flag unknown/misspelled fields")
+	format := flag.String("format", "text", "output format: text, json, sarif")
+	watch := flag.String("watch", "", "watch a file or directory and re-validate on change, instead of exiting")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: yamlvalid [--strict] [--format=text|json|sarif] <path-to-yaml>")
+		fmt.Fprintln(os.Stderr, "       yamlvalid [--strict] --watch <path>")
+		fmt.Fprintln(os.Stderr, "       yamlvalid serve [--tls-cert cert] [--tls-key key] [--addr :8443] [--kinds pods,deployments]")
+	}
+	flag.Parse()
+
+	formatter, ok := validate.FormatterFor(*format)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown --format %q, want text, json, or sarif\n", *format)
+		os.Exit(2)
+	}
+
+	opts, err := validate.LoadRCFile(validate.RCFileName)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(2)
+	}
+	opts.Strict = *strict
+
+	if *watch != "" {
+		if err := runWatch(*watch, opts, formatter); err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(2)
+		}
+		return
+	}
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	path := flag.Arg(0)
+
+	errs := validate.ValidateFileWithOptions(path, opts)
+
+	hasError := false
+	for _, e := range errs {
+		if e.Severity != validate.SeverityWarning {
+			hasError = true
+		}
+	}
+
+	// text goes to stderr, the way plain human output always has; the
+	// machine-readable formats go to stdout so they can be piped straight
+	// into a file or another tool without the shell juggling streams.
+	out := os.Stderr
+	if *format != "" && *format != "text" {
+		out = os.Stdout
+	}
+	if err := formatter.Format(out, errs); err != nil {
+		fmt.Fprintf(os.Stderr, "cannot write output: %v\n", err)
+		os.Exit(2)
+	}
+
+	if hasError {
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
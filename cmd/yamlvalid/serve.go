@@ -0,0 +1,145 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/magomedmertens-lab/praktikum/pkg/validate"
+)
+
+// admissionReview is the subset of the Kubernetes admission.k8s.io/v1
+// AdmissionReview envelope this webhook needs: the incoming request carries
+// an Object, the outgoing response carries Allowed/Status.
+type admissionReview struct {
+	APIVersion string             `json:"apiVersion"`
+	Kind       string             `json:"kind"`
+	Request    *admissionRequest  `json:"request,omitempty"`
+	Response   *admissionResponse `json:"response,omitempty"`
+}
+
+type admissionRequest struct {
+	UID    string          `json:"uid"`
+	Kind   admissionGVK    `json:"kind"`
+	Object json.RawMessage `json:"object"`
+}
+
+type admissionGVK struct {
+	Group   string `json:"group"`
+	Version string `json:"version"`
+	Kind    string `json:"kind"`
+}
+
+type admissionResponse struct {
+	UID     string           `json:"uid"`
+	Allowed bool             `json:"allowed"`
+	Status  *admissionStatus `json:"status,omitempty"`
+}
+
+type admissionStatus struct {
+	Message string `json:"message"`
+}
+
+// runServe starts an HTTPS server implementing the AdmissionReview v1
+// protocol on /validate, gating cluster admission with the same validators
+// the CLI runs against a local file.
+func runServe(args []string) {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	addr := fs.String("addr", ":8443", "address to listen on")
+	certFile := fs.String("tls-cert", "", "path to the TLS certificate (required)")
+	keyFile := fs.String("tls-key", "", "path to the TLS key (required)")
+	kinds := fs.String("kinds", "pods,deployments,services,configmaps,jobs,cronjobs", "comma-separated lowercase plural kinds to admit, e.g. pods,deployments")
+	fs.Parse(args)
+
+	if *certFile == "" || *keyFile == "" {
+		fmt.Fprintln(os.Stderr, "serve: --tls-cert and --tls-key are required")
+		os.Exit(2)
+	}
+
+	opts, err := validate.LoadRCFile(validate.RCFileName)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(2)
+	}
+
+	allowedKinds := map[string]bool{}
+	for _, k := range strings.Split(*kinds, ",") {
+		k = strings.TrimSpace(k)
+		if k != "" {
+			allowedKinds[k] = true
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/validate", admitHandler(opts, allowedKinds))
+
+	log.Printf("yamlvalid serve: listening on %s", *addr)
+	if err := http.ListenAndServeTLS(*addr, *certFile, *keyFile, mux); err != nil {
+		fmt.Fprintf(os.Stderr, "serve: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// admitHandler decodes one AdmissionReview request, runs its .request.object
+// through the same validators as the CLI, and responds with allowed: false
+// plus a status.message built from the accumulated Errors when any
+// error-level one fires. Warning-level findings never deny admission.
+func admitHandler(opts validate.Options, allowedKinds map[string]bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var review admissionReview
+		if err := json.NewDecoder(r.Body).Decode(&review); err != nil {
+			http.Error(w, fmt.Sprintf("cannot decode AdmissionReview: %v", err), http.StatusBadRequest)
+			return
+		}
+		if review.Request == nil {
+			http.Error(w, "AdmissionReview has no request", http.StatusBadRequest)
+			return
+		}
+
+		resp := admissionResponse{UID: review.Request.UID, Allowed: true}
+
+		kindName := strings.ToLower(review.Request.Kind.Kind) + "s"
+		if len(allowedKinds) > 0 && !allowedKinds[kindName] {
+			writeReview(w, resp)
+			return
+		}
+
+		var node yaml.Node
+		if err := yaml.Unmarshal(review.Request.Object, &node); err != nil {
+			resp.Allowed = false
+			resp.Status = &admissionStatus{Message: fmt.Sprintf("cannot parse object: %v", err)}
+			writeReview(w, resp)
+			return
+		}
+
+		var messages []string
+		for _, e := range validate.ValidateWithOptions(&node, opts) {
+			if e.Severity == validate.SeverityWarning {
+				continue
+			}
+			messages = append(messages, e.Msg)
+		}
+		if len(messages) > 0 {
+			resp.Allowed = false
+			resp.Status = &admissionStatus{Message: strings.Join(messages, "; ")}
+		}
+
+		writeReview(w, resp)
+	}
+}
+
+func writeReview(w http.ResponseWriter, resp admissionResponse) {
+	out := admissionReview{
+		APIVersion: "admission.k8s.io/v1",
+		Kind:       "AdmissionReview",
+		Response:   &resp,
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(out)
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/magomedmertens-lab/praktikum/pkg/validate"
+)
+
+func postReview(t *testing.T, handler http.HandlerFunc, body []byte) admissionReview {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/validate", bytes.NewReader(body))
+	rec := httptest.NewRecorder()
+	handler(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body = %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	var review admissionReview
+	if err := json.Unmarshal(rec.Body.Bytes(), &review); err != nil {
+		t.Fatalf("json.Unmarshal response: %v; body = %s", err, rec.Body.String())
+	}
+	return review
+}
+
+func TestAdmitHandlerAllowsValidObject(t *testing.T) {
+	object := []byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"app"},"data":{"key":"value"}}`)
+	reqBody, err := json.Marshal(admissionReview{
+		APIVersion: "admission.k8s.io/v1",
+		Kind:       "AdmissionReview",
+		Request:    &admissionRequest{UID: "1", Kind: admissionGVK{Version: "v1", Kind: "ConfigMap"}, Object: object},
+	})
+	if err != nil {
+		t.Fatalf("json.Marshal: %v", err)
+	}
+
+	handler := admitHandler(validate.Options{}, nil)
+	review := postReview(t, handler, reqBody)
+
+	if review.Response == nil || !review.Response.Allowed {
+		t.Fatalf("expected allowed response, got %+v", review.Response)
+	}
+	if review.Response.UID != "1" {
+		t.Errorf("UID = %q, want %q", review.Response.UID, "1")
+	}
+}
+
+func TestAdmitHandlerDeniesInvalidObject(t *testing.T) {
+	object := []byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{}}`)
+	reqBody, _ := json.Marshal(admissionReview{
+		Request: &admissionRequest{UID: "2", Kind: admissionGVK{Version: "v1", Kind: "ConfigMap"}, Object: object},
+	})
+
+	handler := admitHandler(validate.Options{}, nil)
+	review := postReview(t, handler, reqBody)
+
+	if review.Response == nil || review.Response.Allowed {
+		t.Fatalf("expected denied response, got %+v", review.Response)
+	}
+	if review.Response.Status == nil || review.Response.Status.Message == "" {
+		t.Error("expected a non-empty status message explaining the denial")
+	}
+}
+
+func TestAdmitHandlerSkipsDisallowedKind(t *testing.T) {
+	object := []byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{}}`)
+	reqBody, _ := json.Marshal(admissionReview{
+		Request: &admissionRequest{UID: "3", Kind: admissionGVK{Version: "v1", Kind: "ConfigMap"}, Object: object},
+	})
+
+	// configmaps isn't in the allow-list, so even an invalid object passes
+	// straight through without being validated at all.
+	handler := admitHandler(validate.Options{}, map[string]bool{"pods": true})
+	review := postReview(t, handler, reqBody)
+
+	if review.Response == nil || !review.Response.Allowed {
+		t.Fatalf("expected allowed (unvalidated) response, got %+v", review.Response)
+	}
+}
+
+func TestAdmitHandlerRejectsMalformedBody(t *testing.T) {
+	handler := admitHandler(validate.Options{}, nil)
+	req := httptest.NewRequest(http.MethodPost, "/validate", bytes.NewReader([]byte("not json")))
+	rec := httptest.NewRecorder()
+	handler(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestAdmitHandlerRejectsMissingRequest(t *testing.T) {
+	handler := admitHandler(validate.Options{}, nil)
+	reqBody, _ := json.Marshal(admissionReview{APIVersion: "admission.k8s.io/v1", Kind: "AdmissionReview"})
+	req := httptest.NewRequest(http.MethodPost, "/validate", bytes.NewReader(reqBody))
+	rec := httptest.NewRecorder()
+	handler(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
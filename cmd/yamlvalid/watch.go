@@ -0,0 +1,111 @@
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/magomedmertens-lab/praktikum/pkg/validate"
+)
+
+// watchDebounce absorbs the burst of events an editor's save (write, then
+// often a rename-into-place) produces for a single logical change.
+const watchDebounce = 200 * time.Millisecond
+
+const (
+	ansiGreen = "\033[32m"
+	ansiRed   = "\033[31m"
+	ansiReset = "\033[0m"
+)
+
+// runWatch validates root once, then re-validates whichever file changed on
+// every subsequent fsnotify event. It only returns on a fatal setup error;
+// otherwise it runs until the process is killed.
+func runWatch(root string, opts validate.Options, formatter validate.Formatter) error {
+	info, err := os.Stat(root)
+	if err != nil {
+		return fmt.Errorf("cannot watch %s: %w", root, err)
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("cannot start watcher: %w", err)
+	}
+	defer watcher.Close()
+
+	var files []string
+	if info.IsDir() {
+		walkErr := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				return watcher.Add(path)
+			}
+			if isYAMLFile(path) {
+				files = append(files, path)
+			}
+			return nil
+		})
+		if walkErr != nil {
+			return fmt.Errorf("cannot walk %s: %w", root, walkErr)
+		}
+	} else {
+		files = []string{root}
+		if err := watcher.Add(filepath.Dir(root)); err != nil {
+			return fmt.Errorf("cannot watch %s: %w", root, err)
+		}
+	}
+
+	for _, f := range files {
+		validateAndPrint(f, opts, formatter)
+	}
+
+	// timers debounces per-file: a rapid sequence of events for the same
+	// path resets its own timer instead of queuing a re-validation each.
+	timers := map[string]*time.Timer{}
+	for {
+		select {
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) == 0 || !isYAMLFile(event.Name) {
+				continue
+			}
+			name := event.Name
+			if t, ok := timers[name]; ok {
+				t.Reset(watchDebounce)
+				continue
+			}
+			timers[name] = time.AfterFunc(watchDebounce, func() {
+				validateAndPrint(name, opts, formatter)
+			})
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			fmt.Fprintf(os.Stderr, "watch error: %v\n", err)
+		}
+	}
+}
+
+func isYAMLFile(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	return ext == ".yaml" || ext == ".yml"
+}
+
+func validateAndPrint(path string, opts validate.Options, formatter validate.Formatter) {
+	errs := validate.ValidateFileWithOptions(path, opts)
+	if len(errs) == 0 {
+		fmt.Printf("%s%s: OK%s\n", ansiGreen, path, ansiReset)
+		return
+	}
+	fmt.Printf("%s%s:%s\n", ansiRed, path, ansiReset)
+	formatter.Format(os.Stdout, errs)
+}
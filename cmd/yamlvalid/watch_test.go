@@ -0,0 +1,98 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/magomedmertens-lab/praktikum/pkg/validate"
+)
+
+func TestIsYAMLFile(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{path: "pod.yaml", want: true},
+		{path: "pod.yml", want: true},
+		{path: "pod.YAML", want: true},
+		{path: "dir/pod.yaml", want: true},
+		{path: "pod.json", want: false},
+		{path: "pod.yaml.bak", want: false},
+		{path: "README", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := isYAMLFile(tt.path); got != tt.want {
+				t.Errorf("isYAMLFile(%q) = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateAndPrint(t *testing.T) {
+	validYAML := "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: app\ndata:\n  key: value\n"
+	invalidYAML := "apiVersion: v1\nkind: ConfigMap\nmetadata: {}\n"
+
+	tests := []struct {
+		name        string
+		content     string
+		wantContain string
+		wantAbsent  string
+	}{
+		{name: "valid file prints OK", content: validYAML, wantContain: "OK"},
+		{name: "invalid file prints the path and its errors", content: invalidYAML, wantContain: "metadata.name is required", wantAbsent: "OK"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, "manifest.yaml")
+			if err := os.WriteFile(path, []byte(tt.content), 0o644); err != nil {
+				t.Fatalf("WriteFile: %v", err)
+			}
+
+			out := captureStdout(t, func() {
+				validateAndPrint(path, validate.Options{}, validate.TextFormatter{})
+			})
+
+			if !strings.Contains(out, tt.wantContain) {
+				t.Errorf("output = %q, want substring %q", out, tt.wantContain)
+			}
+			if tt.wantAbsent != "" && strings.Contains(out, tt.wantAbsent) {
+				t.Errorf("output = %q, should not contain %q", out, tt.wantAbsent)
+			}
+		})
+	}
+}
+
+// captureStdout redirects os.Stdout for the duration of fn and returns what
+// was written to it. validateAndPrint writes directly to os.Stdout (it's a
+// CLI helper, not handed a io.Writer), so tests have to intercept it here.
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe: %v", err)
+	}
+	orig := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = orig }()
+
+	fn()
+
+	w.Close()
+	var buf strings.Builder
+	buf.Grow(4096)
+	chunk := make([]byte, 4096)
+	for {
+		n, err := r.Read(chunk)
+		buf.Write(chunk[:n])
+		if err != nil {
+			break
+		}
+	}
+	return buf.String()
+}
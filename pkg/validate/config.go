@@ -0,0 +1,60 @@
+package validate
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// RCFileName is the conventional rc file name. LoadRCFile reads only the
+// path it is given; the CLI passes this name, so it is looked up in the
+// current working directory.
+const RCFileName = ".yamlvalidrc"
+
+// rcFile is the on-disk shape of a .yamlvalidrc: a rule ID mapped to
+// "error", "warning", or "off".
+type rcFile struct {
+	Rules map[string]string `yaml:"rules"`
+}
+
+// LoadRCFile reads a .yamlvalidrc from path and turns it into Options. A
+// missing file is not an error: it returns the zero Options (every rule at
+// its default).
+func LoadRCFile(path string) (Options, error) {
+	content, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return Options{}, nil
+	}
+	if err != nil {
+		return Options{}, fmt.Errorf("cannot read %s: %w", path, err)
+	}
+
+	var rc rcFile
+	if err := yaml.Unmarshal(content, &rc); err != nil {
+		return Options{}, fmt.Errorf("cannot parse %s: %w", path, err)
+	}
+
+	rules := make(map[string]Severity, len(rc.Rules))
+	for id, level := range rc.Rules {
+		severity, err := parseSeverity(level)
+		if err != nil {
+			return Options{}, fmt.Errorf("%s: rule %q: %w", path, id, err)
+		}
+		rules[id] = severity
+	}
+	return Options{Rules: rules}, nil
+}
+
+func parseSeverity(level string) (Severity, error) {
+	switch level {
+	case "error":
+		return SeverityError, nil
+	case "warning":
+		return SeverityWarning, nil
+	case "off":
+		return SeverityOff, nil
+	default:
+		return 0, fmt.Errorf("unknown severity %q, want error/warning/off", level)
+	}
+}
@@ -0,0 +1,22 @@
+package validate
+
+import (
+	"gopkg.in/yaml.v3"
+)
+
+func validateConfigMapObject(file string, doc *yaml.Node, opts Options) []Error {
+	var errs []Error
+
+	errs = append(errs, requireMeta(file, doc, opts)...)
+
+	if data := get(doc, "data"); data != nil {
+		errs = append(errs, validateStringMap(file, data, "data")...)
+	}
+	if binaryData := get(doc, "binaryData"); binaryData != nil {
+		errs = append(errs, validateStringMap(file, binaryData, "binaryData")...)
+	}
+
+	errs = append(errs, checkUnknownFields(file, doc, "", []string{"apiVersion", "kind", "metadata", "data", "binaryData"}, opts)...)
+
+	return errs
+}
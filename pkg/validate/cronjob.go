@@ -0,0 +1,62 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+)
+
+var cronScheduleRe = regexp.MustCompile(`^\S+\s+\S+\s+\S+\s+\S+\s+\S+$`)
+
+func validateCronJobObject(file string, doc *yaml.Node, opts Options) []Error {
+	var errs []Error
+
+	errs = append(errs, requireMeta(file, doc, opts)...)
+
+	spec := mustGet(doc, "spec")
+	if spec == nil {
+		errs = append(errs, ve(file, nil, "spec", "spec is required"))
+		return errs
+	}
+	errs = append(errs, validateCronJobSpec(file, spec, opts)...)
+	return errs
+}
+
+func validateCronJobSpec(file string, n *yaml.Node, opts Options) []Error {
+	if n.Kind != yaml.MappingNode {
+		return []Error{ve(file, n, "spec", "spec must be object")}
+	}
+	var errs []Error
+
+	schedule := mustGet(n, "schedule")
+	if schedule == nil {
+		errs = append(errs, ve(file, nil, "spec.schedule", "spec.schedule is required"))
+	} else if schedule.Kind != yaml.ScalarNode || schedule.Tag != "!!str" {
+		errs = append(errs, ve(file, schedule, "spec.schedule", "spec.schedule must be string"))
+	} else if !cronScheduleRe.MatchString(schedule.Value) {
+		errs = append(errs, ve(file, schedule, "spec.schedule", fmt.Sprintf("spec.schedule has invalid format '%s'", schedule.Value)))
+	}
+
+	jobTemplate := mustGet(n, "jobTemplate")
+	if jobTemplate == nil {
+		errs = append(errs, ve(file, nil, "spec.jobTemplate", "spec.jobTemplate is required"))
+		return errs
+	}
+	if jobTemplate.Kind != yaml.MappingNode {
+		errs = append(errs, ve(file, jobTemplate, "spec.jobTemplate", "spec.jobTemplate must be object"))
+		return errs
+	}
+
+	jobSpec := mustGet(jobTemplate, "spec")
+	if jobSpec == nil {
+		errs = append(errs, ve(file, nil, "spec.jobTemplate.spec", "spec.jobTemplate.spec is required"))
+		return errs
+	}
+	errs = append(errs, validateJobSpec(file, jobSpec, "spec.jobTemplate.spec", opts)...)
+
+	errs = append(errs, checkUnknownFields(file, jobTemplate, "spec.jobTemplate", []string{"spec"}, opts)...)
+	errs = append(errs, checkUnknownFields(file, n, "spec", []string{"schedule", "jobTemplate"}, opts)...)
+
+	return errs
+}
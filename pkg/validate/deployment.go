@@ -0,0 +1,84 @@
+package validate
+
+import (
+	"gopkg.in/yaml.v3"
+)
+
+func validateDeploymentObject(file string, doc *yaml.Node, opts Options) []Error {
+	var errs []Error
+
+	errs = append(errs, requireMeta(file, doc, opts)...)
+
+	spec := mustGet(doc, "spec")
+	if spec == nil {
+		errs = append(errs, ve(file, nil, "spec", "spec is required"))
+		return errs
+	}
+	errs = append(errs, validateDeploymentSpec(file, spec, opts)...)
+	return errs
+}
+
+func validateDeploymentSpec(file string, n *yaml.Node, opts Options) []Error {
+	if n.Kind != yaml.MappingNode {
+		return []Error{ve(file, n, "spec", "spec must be object")}
+	}
+	var errs []Error
+
+	if replicas := get(n, "replicas"); replicas != nil {
+		errs = append(errs, validateNonNegativeInt(file, replicas, "spec.replicas")...)
+	}
+
+	selector := mustGet(n, "selector")
+	if selector == nil {
+		errs = append(errs, ve(file, nil, "spec.selector", "spec.selector is required"))
+	} else if selector.Kind != yaml.MappingNode {
+		errs = append(errs, ve(file, selector, "spec.selector", "spec.selector must be object"))
+	} else {
+		matchLabels := mustGet(selector, "matchLabels")
+		if matchLabels == nil {
+			errs = append(errs, ve(file, nil, "spec.selector.matchLabels", "spec.selector.matchLabels is required"))
+		} else {
+			errs = append(errs, validateStringMap(file, matchLabels, "spec.selector.matchLabels")...)
+		}
+	}
+
+	template := mustGet(n, "template")
+	if template == nil {
+		errs = append(errs, ve(file, nil, "spec.template", "spec.template is required"))
+		return errs
+	}
+	errs = append(errs, validatePodTemplate(file, template, "spec.template", opts)...)
+
+	errs = append(errs, checkUnknownFields(file, n, "spec", []string{"replicas", "selector", "template"}, opts)...)
+
+	return errs
+}
+
+// validatePodTemplate validates the `template:` field shared by Deployment
+// and Job: an optional metadata.labels plus a required pod spec.
+func validatePodTemplate(file string, n *yaml.Node, path string, opts Options) []Error {
+	if n.Kind != yaml.MappingNode {
+		return []Error{ve(file, n, path, path+" must be object")}
+	}
+	var errs []Error
+
+	if meta := get(n, "metadata"); meta != nil {
+		if meta.Kind != yaml.MappingNode {
+			errs = append(errs, ve(file, meta, path+".metadata", path+".metadata must be object"))
+		} else {
+			if labels := get(meta, "labels"); labels != nil {
+				errs = append(errs, validateStringMap(file, labels, path+".metadata.labels")...)
+			}
+			errs = append(errs, checkUnknownFields(file, meta, path+".metadata", []string{"labels"}, opts)...)
+		}
+	}
+
+	spec := mustGet(n, "spec")
+	if spec == nil {
+		errs = append(errs, ve(file, nil, path+".spec", path+".spec is required"))
+	} else {
+		errs = append(errs, validatePodSpec(file, spec, opts)...)
+	}
+
+	return errs
+}
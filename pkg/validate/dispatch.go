@@ -0,0 +1,81 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// GroupVersionKind identifies the shape of a manifest's apiVersion+kind,
+// e.g. {Group: "apps", Version: "v1", Kind: "Deployment"} or
+// {Version: "v1", Kind: "Pod"} for core/v1 kinds.
+type GroupVersionKind struct {
+	Group   string
+	Version string
+	Kind    string
+}
+
+// objectValidator validates a single manifest document, given that its
+// apiVersion/kind have already been dispatched on.
+type objectValidator func(file string, doc *yaml.Node, opts Options) []Error
+
+var registry = map[GroupVersionKind]objectValidator{
+	{Version: "v1", Kind: "Pod"}:                       validatePodObject,
+	{Version: "v1", Kind: "Service"}:                   validateServiceObject,
+	{Version: "v1", Kind: "ConfigMap"}:                 validateConfigMapObject,
+	{Group: "apps", Version: "v1", Kind: "Deployment"}: validateDeploymentObject,
+	{Group: "batch", Version: "v1", Kind: "Job"}:       validateJobObject,
+	{Group: "batch", Version: "v1", Kind: "CronJob"}:   validateCronJobObject,
+}
+
+func gvkFromAPIVersion(apiVersion, kind string) GroupVersionKind {
+	if group, version, ok := strings.Cut(apiVersion, "/"); ok {
+		return GroupVersionKind{Group: group, Version: version, Kind: kind}
+	}
+	return GroupVersionKind{Version: apiVersion, Kind: kind}
+}
+
+// validateDocument dispatches a single parsed document (as returned by
+// firstDocument) to the registered validator for its apiVersion+kind.
+func validateDocument(file string, doc *yaml.Node, opts Options) []Error {
+	if doc.Kind != yaml.MappingNode {
+		return []Error{ve(file, doc, "", "root must be object")}
+	}
+
+	apiV := mustGet(doc, "apiVersion")
+	kind := mustGet(doc, "kind")
+
+	if apiV == nil || kind == nil {
+		var errs []Error
+		if apiV == nil {
+			errs = append(errs, ve(file, nil, "apiVersion", "apiVersion is required"))
+		}
+		if kind == nil {
+			errs = append(errs, ve(file, nil, "kind", "kind is required"))
+		}
+		if meta := mustGet(doc, "metadata"); meta == nil {
+			errs = append(errs, ve(file, nil, "metadata", "metadata is required"))
+		} else {
+			errs = append(errs, validateObjectMeta(file, meta, opts)...)
+		}
+		return errs
+	}
+
+	gvk := gvkFromAPIVersion(apiV.Value, kind.Value)
+	validate, ok := registry[gvk]
+	if !ok {
+		return []Error{ve(file, kind, "kind", fmt.Sprintf("kind has unsupported value '%s'", kind.Value))}
+	}
+	return validate(file, doc, opts)
+}
+
+// requireMeta fetches and validates the shared metadata field that every
+// registered kind carries.
+func requireMeta(file string, doc *yaml.Node, opts Options) []Error {
+	meta := mustGet(doc, "metadata")
+	if meta == nil {
+		return []Error{ve(file, nil, "metadata", "metadata is required")}
+	}
+	return validateObjectMeta(file, meta, opts)
+}
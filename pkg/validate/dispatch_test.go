@@ -0,0 +1,260 @@
+package validate
+
+import "testing"
+
+func TestValidateDocumentDispatch(t *testing.T) {
+	const validPod = `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: app
+spec:
+  containers:
+  - name: app
+    image: registry.bigbrother.io/app:v1
+    resources:
+      requests:
+        memory: 128Mi
+`
+	const validDeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+spec:
+  selector:
+    matchLabels:
+      app: app
+  template:
+    spec:
+      containers:
+      - name: app
+        image: registry.bigbrother.io/app:v1
+        resources:
+          requests:
+            memory: 128Mi
+`
+	const validService = `
+apiVersion: v1
+kind: Service
+metadata:
+  name: app
+spec:
+  ports:
+  - port: 80
+`
+	const validConfigMap = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: app
+data:
+  key: value
+`
+	const validJob = `
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: app
+spec:
+  template:
+    spec:
+      containers:
+      - name: app
+        image: registry.bigbrother.io/app:v1
+        resources:
+          requests:
+            memory: 128Mi
+`
+	const validCronJob = `
+apiVersion: batch/v1
+kind: CronJob
+metadata:
+  name: app
+spec:
+  schedule: "* * * * *"
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          containers:
+          - name: app
+            image: registry.bigbrother.io/app:v1
+            resources:
+              requests:
+                memory: 128Mi
+`
+
+	tests := []struct {
+		name    string
+		src     string
+		wantErr bool
+	}{
+		{name: "Pod routes to validatePodObject", src: validPod},
+		{name: "Deployment routes to validateDeploymentObject", src: validDeployment},
+		{name: "Service routes to validateServiceObject", src: validService},
+		{name: "ConfigMap routes to validateConfigMapObject", src: validConfigMap},
+		{name: "Job routes to validateJobObject", src: validJob},
+		{name: "CronJob routes to validateCronJobObject", src: validCronJob},
+		{
+			name:    "unsupported kind is reported, not silently skipped",
+			src:     "apiVersion: v1\nkind: Secret\nmetadata:\n  name: app\n",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported apiVersion for a known kind is reported",
+			src:     "apiVersion: v2\nkind: Pod\nmetadata:\n  name: app\n",
+			wantErr: true,
+		},
+		{
+			name:    "root that isn't an object is reported",
+			src:     "- just\n- a\n- list\n",
+			wantErr: true,
+		},
+		{
+			name:    "missing apiVersion/kind still validates metadata",
+			src:     "metadata:\n  name: app\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := parseNode(t, tt.src)
+			errs := validateDocument("f", node, Options{})
+			if got := len(errs) > 0; got != tt.wantErr {
+				t.Fatalf("got errs=%v (wantErr=%v): %+v", got, tt.wantErr, errs)
+			}
+		})
+	}
+}
+
+func TestGVKFromAPIVersion(t *testing.T) {
+	tests := []struct {
+		apiVersion string
+		kind       string
+		want       GroupVersionKind
+	}{
+		{apiVersion: "v1", kind: "Pod", want: GroupVersionKind{Version: "v1", Kind: "Pod"}},
+		{apiVersion: "apps/v1", kind: "Deployment", want: GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}},
+		{apiVersion: "batch/v1", kind: "CronJob", want: GroupVersionKind{Group: "batch", Version: "v1", Kind: "CronJob"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.apiVersion+"/"+tt.kind, func(t *testing.T) {
+			if got := gvkFromAPIVersion(tt.apiVersion, tt.kind); got != tt.want {
+				t.Errorf("gvkFromAPIVersion(%q, %q) = %+v, want %+v", tt.apiVersion, tt.kind, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateBytesMultiDocument(t *testing.T) {
+	const stream = `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: app
+spec:
+  containers:
+  - name: app
+    image: notvalid
+    resources:
+      requests:
+        memory: 128Mi
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cfg
+data:
+  key: value
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: broken
+`
+	errs := ValidateBytes([]byte(stream))
+
+	wantDocs := map[int]bool{1: false, 3: false}
+	for _, e := range errs {
+		if _, ok := wantDocs[e.Doc]; ok {
+			wantDocs[e.Doc] = true
+		}
+		if e.Doc == 2 {
+			t.Errorf("document 2 (valid ConfigMap) produced an error: %+v", e)
+		}
+	}
+	for doc, seen := range wantDocs {
+		if !seen {
+			t.Errorf("expected at least one error for document %d, got none: %+v", doc, errs)
+		}
+	}
+}
+
+func TestPerKindValidators(t *testing.T) {
+	tests := []struct {
+		name     string
+		validate func(t *testing.T) []Error
+		wantErr  bool
+	}{
+		{
+			name: "Deployment missing selector",
+			validate: func(t *testing.T) []Error {
+				doc := parseNode(t, "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: app\nspec:\n  template:\n    spec:\n      containers: []\n")
+				return validateDeploymentObject("f", doc, Options{})
+			},
+			wantErr: true,
+		},
+		{
+			name: "Service missing ports",
+			validate: func(t *testing.T) []Error {
+				doc := parseNode(t, "apiVersion: v1\nkind: Service\nmetadata:\n  name: app\nspec:\n  selector:\n    app: app\n")
+				return validateServiceObject("f", doc, Options{})
+			},
+			wantErr: true,
+		},
+		{
+			name: "Service port out of range",
+			validate: func(t *testing.T) []Error {
+				doc := parseNode(t, "apiVersion: v1\nkind: Service\nmetadata:\n  name: app\nspec:\n  ports:\n  - port: 99999\n")
+				return validateServiceObject("f", doc, Options{})
+			},
+			wantErr: true,
+		},
+		{
+			name: "ConfigMap with non-string data value",
+			validate: func(t *testing.T) []Error {
+				doc := parseNode(t, "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: app\ndata:\n  key: 5\n")
+				return validateConfigMapObject("f", doc, Options{})
+			},
+			wantErr: true,
+		},
+		{
+			name: "Job missing template",
+			validate: func(t *testing.T) []Error {
+				doc := parseNode(t, "apiVersion: batch/v1\nkind: Job\nmetadata:\n  name: app\nspec:\n  backoffLimit: 2\n")
+				return validateJobObject("f", doc, Options{})
+			},
+			wantErr: true,
+		},
+		{
+			name: "CronJob with invalid schedule",
+			validate: func(t *testing.T) []Error {
+				doc := parseNode(t, "apiVersion: batch/v1\nkind: CronJob\nmetadata:\n  name: app\nspec:\n  schedule: \"not a schedule\"\n  jobTemplate:\n    spec:\n      template:\n        spec:\n          containers: []\n")
+				return validateCronJobObject("f", doc, Options{})
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.validate(t)
+			if got := len(errs) > 0; got != tt.wantErr {
+				t.Fatalf("got errs=%v (wantErr=%v): %+v", got, tt.wantErr, errs)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package validate
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Formatter renders a slice of Error for a particular consumer: a human at
+// a terminal, a CI system parsing JSON, or a SARIF-ingesting code scanner.
+type Formatter interface {
+	Format(w io.Writer, errs []Error) error
+}
+
+// FormatterFor resolves a --format flag value to a Formatter. ok is false
+// for an unrecognized name.
+func FormatterFor(name string) (f Formatter, ok bool) {
+	switch name {
+	case "", "text":
+		return TextFormatter{}, true
+	case "json":
+		return JSONFormatter{}, true
+	case "sarif":
+		return SARIFFormatter{}, true
+	default:
+		return nil, false
+	}
+}
+
+// TextFormatter is the original free-form format: one finding per line as
+// "file:line message" ("file[doc=N]:line message" from the second document
+// of a stream onward, just "message" when the finding has no line), with
+// warnings prefixed "warning: ".
+type TextFormatter struct{}
+
+func (TextFormatter) Format(w io.Writer, errs []Error) error {
+	for _, e := range errs {
+		file := e.File
+		if e.Doc > 1 {
+			file = fmt.Sprintf("%s[doc=%d]", file, e.Doc)
+		}
+		msg := e.Msg
+		if e.Severity == SeverityWarning {
+			msg = "warning: " + msg
+		}
+		var err error
+		if e.Line > 0 {
+			_, err = fmt.Fprintf(w, "%s:%d %s\n", file, e.Line, msg)
+		} else {
+			_, err = fmt.Fprintf(w, "%s\n", msg)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// jsonFinding is the on-the-wire shape of a single JSONFormatter entry.
+type jsonFinding struct {
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+	Column   int    `json:"column"`
+	Path     string `json:"path"`
+	RuleID   string `json:"ruleID"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+}
+
+// JSONFormatter emits errs as a single JSON array, for tooling that wants
+// to consume findings programmatically rather than scrape stderr.
+type JSONFormatter struct{}
+
+func (JSONFormatter) Format(w io.Writer, errs []Error) error {
+	out := make([]jsonFinding, 0, len(errs))
+	for _, e := range errs {
+		out = append(out, jsonFinding{
+			File:     e.File,
+			Line:     e.Line,
+			Column:   e.Column,
+			Path:     e.Path,
+			RuleID:   e.RuleID,
+			Severity: e.Severity.String(),
+			Message:  e.Msg,
+		})
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
+
+// sarifToolName names this validator as the SARIF run's tool driver.
+const sarifToolName = "yamlvalid"
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name string `json:"name"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	// Region is omitted when the finding has no line (SARIF 2.1.0 requires
+	// region.startLine >= 1 when region is present at all).
+	Region *sarifRegion `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+// SARIFFormatter emits errs as a SARIF 2.1.0 log, so GitHub Code Scanning /
+// GitLab can ingest findings directly and annotate pull requests inline.
+type SARIFFormatter struct{}
+
+func (SARIFFormatter) Format(w io.Writer, errs []Error) error {
+	results := make([]sarifResult, 0, len(errs))
+	for _, e := range errs {
+		loc := sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: e.File}}
+		if e.Line > 0 {
+			loc.Region = &sarifRegion{StartLine: e.Line}
+		}
+		results = append(results, sarifResult{
+			RuleID:    e.RuleID,
+			Level:     sarifLevel(e.Severity),
+			Message:   sarifMessage{Text: e.Msg},
+			Locations: []sarifLocation{{PhysicalLocation: loc}},
+		})
+	}
+
+	doc := sarifLog{
+		Schema:  "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json",
+		Version: "2.1.0",
+		Runs: []sarifRun{{
+			Tool:    sarifTool{Driver: sarifDriver{Name: sarifToolName}},
+			Results: results,
+		}},
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
+
+func sarifLevel(s Severity) string {
+	switch s {
+	case SeverityWarning:
+		return "warning"
+	case SeverityOff:
+		return "note"
+	default:
+		return "error"
+	}
+}
@@ -0,0 +1,139 @@
+package validate
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestFormatterFor(t *testing.T) {
+	tests := []struct {
+		name     string
+		wantOK   bool
+		wantType Formatter
+	}{
+		{name: "", wantOK: true, wantType: TextFormatter{}},
+		{name: "text", wantOK: true, wantType: TextFormatter{}},
+		{name: "json", wantOK: true, wantType: JSONFormatter{}},
+		{name: "sarif", wantOK: true, wantType: SARIFFormatter{}},
+		{name: "xml", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, ok := FormatterFor(tt.name)
+			if ok != tt.wantOK {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && f != tt.wantType {
+				t.Errorf("FormatterFor(%q) = %#v, want %#v", tt.name, f, tt.wantType)
+			}
+		})
+	}
+}
+
+func TestTextFormatter(t *testing.T) {
+	errs := []Error{
+		{File: "pod.yaml", Line: 5, Msg: "containers.image has invalid format 'notvalid'"},
+		{File: "pod.yaml", Msg: "spec.containers is required"},
+		{File: "pod.yaml", Line: 3, Severity: SeverityWarning, Msg: "memory has invalid format '128'"},
+		{File: "pod.yaml", Line: 2, Doc: 2, Msg: "metadata.name is required"},
+	}
+
+	var buf bytes.Buffer
+	if err := (TextFormatter{}).Format(&buf, errs); err != nil {
+		t.Fatalf("Format: %v", err)
+	}
+
+	want := "pod.yaml:5 containers.image has invalid format 'notvalid'\n" +
+		"spec.containers is required\n" +
+		"pod.yaml:3 warning: memory has invalid format '128'\n" +
+		"pod.yaml[doc=2]:2 metadata.name is required\n"
+	if buf.String() != want {
+		t.Errorf("Format() =\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func TestJSONFormatter(t *testing.T) {
+	errs := []Error{
+		{File: "pod.yaml", Line: 5, Column: 3, Path: "spec.containers[0].image", RuleID: "image-registry-prefix", Msg: "bad image"},
+	}
+
+	var buf bytes.Buffer
+	if err := (JSONFormatter{}).Format(&buf, errs); err != nil {
+		t.Fatalf("Format: %v", err)
+	}
+
+	var got []jsonFinding
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	want := []jsonFinding{
+		{File: "pod.yaml", Line: 5, Column: 3, Path: "spec.containers[0].image", RuleID: "image-registry-prefix", Severity: "error", Message: "bad image"},
+	}
+	if len(got) != len(want) || got[0] != want[0] {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestJSONFormatterEmptyIsEmptyArray(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (JSONFormatter{}).Format(&buf, nil); err != nil {
+		t.Fatalf("Format: %v", err)
+	}
+	if got := strings.TrimSpace(buf.String()); got != "[]" {
+		t.Errorf("Format(nil) = %q, want %q", got, "[]")
+	}
+}
+
+func TestSARIFFormatterOmitsRegionWithoutLine(t *testing.T) {
+	errs := []Error{
+		{File: "pod.yaml", Line: 5, RuleID: "image-registry-prefix", Msg: "bad image"},
+		{File: "pod.yaml", RuleID: "schema", Msg: "spec.containers is required"},
+	}
+
+	var buf bytes.Buffer
+	if err := (SARIFFormatter{}).Format(&buf, errs); err != nil {
+		t.Fatalf("Format: %v", err)
+	}
+
+	var doc sarifLog
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	results := doc.Runs[0].Results
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+	if results[0].Locations[0].PhysicalLocation.Region == nil {
+		t.Error("result with Line=5 should have a region")
+	} else if results[0].Locations[0].PhysicalLocation.Region.StartLine != 5 {
+		t.Errorf("StartLine = %d, want 5", results[0].Locations[0].PhysicalLocation.Region.StartLine)
+	}
+	if results[1].Locations[0].PhysicalLocation.Region != nil {
+		t.Errorf("result with Line=0 should omit region, got %+v", results[1].Locations[0].PhysicalLocation.Region)
+	}
+
+	// Belt-and-braces: the raw JSON must not contain a startLine of 0,
+	// which SARIF 2.1.0 consumers reject outright.
+	if strings.Contains(buf.String(), `"startLine": 0`) {
+		t.Error("raw SARIF output contains an invalid startLine: 0")
+	}
+}
+
+func TestSARIFLevel(t *testing.T) {
+	tests := []struct {
+		severity Severity
+		want     string
+	}{
+		{SeverityError, "error"},
+		{SeverityWarning, "warning"},
+		{SeverityOff, "note"},
+	}
+	for _, tt := range tests {
+		if got := sarifLevel(tt.severity); got != tt.want {
+			t.Errorf("sarifLevel(%v) = %q, want %q", tt.severity, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package validate
+
+import (
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+func firstDocument(root *yaml.Node) *yaml.Node {
+	// root.Kind usually DocumentNode, root.Content[0] => MappingNode
+	if root == nil {
+		return nil
+	}
+	if root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+		return root.Content[0]
+	}
+	// sometimes yaml.Unmarshal gives root with Content as docs
+	if len(root.Content) > 0 {
+		return root.Content[0]
+	}
+	return nil
+}
+
+func mustGet(m *yaml.Node, key string) *yaml.Node {
+	return get(m, key)
+}
+
+func get(m *yaml.Node, key string) *yaml.Node {
+	if m == nil || m.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i < len(m.Content); i += 2 {
+		k := m.Content[i]
+		v := m.Content[i+1]
+		if k.Kind == yaml.ScalarNode && k.Value == key {
+			return v
+		}
+	}
+	return nil
+}
+
+func validImage(s string) bool {
+	// must be in registry.bigbrother.io and have tag
+	if !strings.HasPrefix(s, "registry.bigbrother.io/") {
+		return false
+	}
+	// tag required
+	lastSlash := strings.LastIndex(s, "/")
+	colon := strings.LastIndex(s, ":")
+	return colon > lastSlash && colon < len(s)-1
+}
+
+var memRe = regexp.MustCompile(`^[0-9]+(Ki|Mi|Gi)$`)
+
+func validMemory(s string) bool {
+	return memRe.MatchString(strings.TrimSpace(s))
+}
+
+// schemaRuleID is the RuleID stamped on structural findings (the embedded
+// OpenAPI schema walk and the hand-rolled per-kind checks) rather than on
+// findings from a pluggable Rule.
+const schemaRuleID = "schema"
+
+// ve builds a structural Error positioned at n, or at no position at all
+// (Line and Column both 0) when n is nil, e.g. for a required field that
+// doesn't exist in the document to point at.
+func ve(file string, n *yaml.Node, path string, msg string) Error {
+	e := Error{File: file, Path: path, RuleID: schemaRuleID, Msg: msg}
+	if n != nil {
+		e.Line = n.Line
+		e.Column = n.Column
+	}
+	return e
+}
@@ -0,0 +1,41 @@
+package validate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// validateNonNegativeInt checks that n is a scalar parseable as an integer
+// >= 0, e.g. spec.replicas or spec.backoffLimit.
+func validateNonNegativeInt(file string, n *yaml.Node, path string) []Error {
+	if n.Kind != yaml.ScalarNode {
+		return []Error{ve(file, n, path, fmt.Sprintf("%s must be int", path))}
+	}
+	v, err := strconv.Atoi(strings.TrimSpace(n.Value))
+	if err != nil {
+		return []Error{ve(file, n, path, fmt.Sprintf("%s must be int", path))}
+	}
+	if v < 0 {
+		return []Error{ve(file, n, path, fmt.Sprintf("%s value out of range", path))}
+	}
+	return nil
+}
+
+// validatePortInt checks that n is a scalar parseable as a TCP/UDP port
+// number (1-65535).
+func validatePortInt(file string, n *yaml.Node, path string, field string) []Error {
+	if n.Kind != yaml.ScalarNode {
+		return []Error{ve(file, n, path, fmt.Sprintf("%s must be int", field))}
+	}
+	v, err := strconv.Atoi(strings.TrimSpace(n.Value))
+	if err != nil {
+		return []Error{ve(file, n, path, fmt.Sprintf("%s must be int", field))}
+	}
+	if v <= 0 || v >= 65536 {
+		return []Error{ve(file, n, path, fmt.Sprintf("%s value out of range", field))}
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package validate
+
+import (
+	"gopkg.in/yaml.v3"
+)
+
+func validateJobObject(file string, doc *yaml.Node, opts Options) []Error {
+	var errs []Error
+
+	errs = append(errs, requireMeta(file, doc, opts)...)
+
+	spec := mustGet(doc, "spec")
+	if spec == nil {
+		errs = append(errs, ve(file, nil, "spec", "spec is required"))
+		return errs
+	}
+	errs = append(errs, validateJobSpec(file, spec, "spec", opts)...)
+	return errs
+}
+
+// validateJobSpec validates the `spec:` shape of a Job, and is reused for
+// CronJob's `spec.jobTemplate.spec`.
+func validateJobSpec(file string, n *yaml.Node, path string, opts Options) []Error {
+	if n.Kind != yaml.MappingNode {
+		return []Error{ve(file, n, path, path+" must be object")}
+	}
+	var errs []Error
+
+	if backoffLimit := get(n, "backoffLimit"); backoffLimit != nil {
+		errs = append(errs, validateNonNegativeInt(file, backoffLimit, path+".backoffLimit")...)
+	}
+
+	template := mustGet(n, "template")
+	if template == nil {
+		errs = append(errs, ve(file, nil, path+".template", path+".template is required"))
+		return errs
+	}
+	errs = append(errs, validatePodTemplate(file, template, path+".template", opts)...)
+
+	errs = append(errs, checkUnknownFields(file, n, path, []string{"backoffLimit", "template"}, opts)...)
+
+	return errs
+}
@@ -0,0 +1,70 @@
+package validate
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// validateObjectMeta is shared across every registered kind's `metadata`
+// field.
+func validateObjectMeta(file string, n *yaml.Node, opts Options) []Error {
+	var errs []Error
+	if n.Kind != yaml.MappingNode {
+		return []Error{ve(file, n, "metadata", "metadata must be object")}
+	}
+
+	name := mustGet(n, "name")
+	if name == nil {
+		errs = append(errs, ve(file, nil, "metadata.name", "metadata.name is required"))
+	} else {
+		errs = append(errs, validateScalarType(file, name, "metadata.name", "string")...)
+	}
+
+	// namespace optional
+	if ns := get(n, "namespace"); ns != nil {
+		errs = append(errs, validateScalarType(file, ns, "metadata.namespace", "string")...)
+	}
+
+	// labels optional: object of string->string
+	if labels := get(n, "labels"); labels != nil {
+		errs = append(errs, validateStringMap(file, labels, "metadata.labels")...)
+	}
+
+	errs = append(errs, checkUnknownFields(file, n, "metadata", []string{"name", "namespace", "labels"}, opts)...)
+
+	return errs
+}
+
+// validateStringMap checks that n is a mapping node whose keys and values
+// are both strings. It backs metadata.labels, selector.matchLabels,
+// ConfigMap.data, and similar string->string fields.
+func validateStringMap(file string, n *yaml.Node, path string) []Error {
+	if n.Kind != yaml.MappingNode {
+		return []Error{ve(file, n, path, fmt.Sprintf("%s must be object", path))}
+	}
+	var errs []Error
+	for i := 0; i < len(n.Content); i += 2 {
+		k := n.Content[i]
+		v := n.Content[i+1]
+		if k.Kind != yaml.ScalarNode || k.Tag != "!!str" {
+			errs = append(errs, ve(file, k, path, fmt.Sprintf("%s key must be string", path)))
+		}
+		if v.Kind != yaml.ScalarNode || v.Tag != "!!str" {
+			errs = append(errs, ve(file, v, fmt.Sprintf("%s.%s", path, k.Value), fmt.Sprintf("%s value must be string", path)))
+		}
+	}
+	return errs
+}
+
+func validateScalarType(file string, n *yaml.Node, field string, want string) []Error {
+	switch want {
+	case "string":
+		if n.Kind != yaml.ScalarNode || n.Tag != "!!str" {
+			return []Error{ve(file, n, field, fmt.Sprintf("%s must be %s", field, want))}
+		}
+	default:
+		return []Error{ve(file, n, field, fmt.Sprintf("%s must be %s", field, want))}
+	}
+	return nil
+}
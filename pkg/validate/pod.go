@@ -0,0 +1,40 @@
+package validate
+
+import (
+	_ "embed"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed schemas/pod.v1.json
+var podSpecSchemaJSON []byte
+
+var podSpecSchema, podSpecDefs = mustLoadSchema(podSpecSchemaJSON)
+
+func validatePodObject(file string, doc *yaml.Node, opts Options) []Error {
+	var errs []Error
+
+	errs = append(errs, requireMeta(file, doc, opts)...)
+
+	spec := mustGet(doc, "spec")
+	if spec == nil {
+		errs = append(errs, ve(file, nil, "spec", "spec is required"))
+		return errs
+	}
+	errs = append(errs, validatePodSpec(file, spec, opts)...)
+	return errs
+}
+
+// validatePodSpec checks spec against the embedded Pod OpenAPI schema
+// (schemas/pod.v1.json), then runs the rule registry for the policy checks
+// the schema can't express: image registry, container name format, memory
+// suffix, port range, and (opt-in) unknown fields.
+func validatePodSpec(file string, n *yaml.Node, opts Options) []Error {
+	if n.Kind != yaml.MappingNode {
+		return []Error{ve(file, n, "spec", "spec must be object")}
+	}
+
+	errs := evalSchema(file, podSpecDefs, podSpecSchema, n, "spec", evalOpts{})
+	errs = append(errs, runRules(file, n, opts)...)
+	return errs
+}
@@ -0,0 +1,55 @@
+package validate
+
+import "gopkg.in/yaml.v3"
+
+// Finding is a single pluggable-rule violation. Unlike Error it has no File
+// (the caller already knows which file it's validating) and no Severity
+// (the engine stamps that in from the resolved rule config before turning
+// it into an Error).
+type Finding struct {
+	Line   int
+	Column int
+	Path   string
+	Msg    string
+}
+
+// Rule is a pluggable, independently enable/disable/severity-configurable
+// check, discovered via the package-level rule registry rather than called
+// directly from validatePodSpec.
+type Rule interface {
+	ID() string
+	DefaultSeverity() Severity
+	Check(file string, spec *yaml.Node) []Finding
+}
+
+var ruleRegistry = []Rule{
+	imageRegistryPrefixRule{},
+	containerNameFormatRule{},
+	memorySuffixRule{},
+	portRangeRule{},
+	unknownFieldsRule{},
+}
+
+// runRules runs every registered rule that opts hasn't disabled, at the
+// severity opts (or the rule's own default) assigns it.
+func runRules(file string, spec *yaml.Node, opts Options) []Error {
+	var errs []Error
+	for _, r := range ruleRegistry {
+		severity, enabled := opts.severityFor(r)
+		if !enabled {
+			continue
+		}
+		for _, f := range r.Check(file, spec) {
+			errs = append(errs, Error{
+				File:     file,
+				Line:     f.Line,
+				Column:   f.Column,
+				Path:     f.Path,
+				RuleID:   r.ID(),
+				Severity: severity,
+				Msg:      f.Msg,
+			})
+		}
+	}
+	return errs
+}
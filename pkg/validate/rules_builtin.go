@@ -0,0 +1,204 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// imageRegistryPrefixRule requires every container image to come from our
+// registry, e.g. registry.bigbrother.io/app:v1.
+type imageRegistryPrefixRule struct{}
+
+func (imageRegistryPrefixRule) ID() string                { return "image-registry-prefix" }
+func (imageRegistryPrefixRule) DefaultSeverity() Severity { return SeverityError }
+
+func (imageRegistryPrefixRule) Check(file string, spec *yaml.Node) []Finding {
+	return forEachContainer(spec, func(idx int, c *yaml.Node, path string) []Finding {
+		image := get(c, "image")
+		if image == nil || image.Kind != yaml.ScalarNode || image.Tag != "!!str" {
+			return nil
+		}
+		if validImage(image.Value) {
+			return nil
+		}
+		return []Finding{{
+			Line:   image.Line,
+			Column: image.Column,
+			Path:   path + ".image",
+			Msg:    fmt.Sprintf("containers.image has invalid format '%s'", image.Value),
+		}}
+	})
+}
+
+// containerNameFormatRule requires container names to match our naming
+// convention (lower_snake_case) and be unique within the pod.
+type containerNameFormatRule struct{}
+
+func (containerNameFormatRule) ID() string                { return "container-name-format" }
+func (containerNameFormatRule) DefaultSeverity() Severity { return SeverityError }
+
+var containerNameRe = regexp.MustCompile(`^[a-z]+(_[a-z0-9]+)*$`)
+
+func (containerNameFormatRule) Check(file string, spec *yaml.Node) []Finding {
+	seen := map[string]bool{}
+	return forEachContainer(spec, func(idx int, c *yaml.Node, path string) []Finding {
+		name := get(c, "name")
+		if name == nil || name.Kind != yaml.ScalarNode || name.Tag != "!!str" {
+			return nil
+		}
+		var findings []Finding
+		if !containerNameRe.MatchString(name.Value) {
+			findings = append(findings, Finding{
+				Line:   name.Line,
+				Column: name.Column,
+				Path:   path + ".name",
+				Msg:    fmt.Sprintf("containers.name has invalid format '%s'", name.Value),
+			})
+		}
+		if seen[name.Value] {
+			findings = append(findings, Finding{
+				Line:   name.Line,
+				Column: name.Column,
+				Path:   path + ".name",
+				Msg:    fmt.Sprintf("containers.name has invalid format '%s'", name.Value),
+			})
+		}
+		seen[name.Value] = true
+		return findings
+	})
+}
+
+// memorySuffixRule requires resources.{requests,limits}.memory to carry a
+// Ki/Mi/Gi suffix.
+type memorySuffixRule struct{}
+
+func (memorySuffixRule) ID() string                { return "memory-suffix" }
+func (memorySuffixRule) DefaultSeverity() Severity { return SeverityError }
+
+func (memorySuffixRule) Check(file string, spec *yaml.Node) []Finding {
+	return forEachContainer(spec, func(idx int, c *yaml.Node, path string) []Finding {
+		res := get(c, "resources")
+		if res == nil || res.Kind != yaml.MappingNode {
+			return nil
+		}
+		var findings []Finding
+		for _, field := range []string{"requests", "limits"} {
+			rl := get(res, field)
+			if rl == nil || rl.Kind != yaml.MappingNode {
+				continue
+			}
+			mem := get(rl, "memory")
+			if mem == nil || mem.Kind != yaml.ScalarNode || mem.Tag != "!!str" {
+				continue
+			}
+			if !validMemory(mem.Value) {
+				findings = append(findings, Finding{
+					Line:   mem.Line,
+					Column: mem.Column,
+					Path:   fmt.Sprintf("%s.resources.%s.memory", path, field),
+					Msg:    fmt.Sprintf("memory has invalid format '%s'", mem.Value),
+				})
+			}
+		}
+		return findings
+	})
+}
+
+// portRangeRule requires every containerPort / probe port to be a valid
+// TCP/UDP port number (1-65535).
+type portRangeRule struct{}
+
+func (portRangeRule) ID() string                { return "port-range" }
+func (portRangeRule) DefaultSeverity() Severity { return SeverityError }
+
+func (portRangeRule) Check(file string, spec *yaml.Node) []Finding {
+	return forEachContainer(spec, func(idx int, c *yaml.Node, path string) []Finding {
+		var findings []Finding
+
+		if ports := get(c, "ports"); ports != nil && ports.Kind == yaml.SequenceNode {
+			for i, p := range ports.Content {
+				if p.Kind != yaml.MappingNode {
+					continue
+				}
+				if cp := get(p, "containerPort"); cp != nil {
+					if f, ok := checkPortRange(cp, fmt.Sprintf("%s.ports[%d].containerPort", path, i)); !ok {
+						findings = append(findings, f)
+					}
+				}
+			}
+		}
+
+		for _, probeField := range []string{"readinessProbe", "livenessProbe"} {
+			probe := get(c, probeField)
+			if probe == nil || probe.Kind != yaml.MappingNode {
+				continue
+			}
+			httpGet := get(probe, "httpGet")
+			if httpGet == nil || httpGet.Kind != yaml.MappingNode {
+				continue
+			}
+			if port := get(httpGet, "port"); port != nil {
+				if f, ok := checkPortRange(port, fmt.Sprintf("%s.%s.httpGet.port", path, probeField)); !ok {
+					findings = append(findings, f)
+				}
+			}
+		}
+
+		return findings
+	})
+}
+
+func checkPortRange(n *yaml.Node, path string) (Finding, bool) {
+	if n.Kind != yaml.ScalarNode {
+		return Finding{}, true
+	}
+	v, err := strconv.Atoi(strings.TrimSpace(n.Value))
+	if err != nil {
+		return Finding{}, true
+	}
+	if v <= 0 || v >= 65536 {
+		return Finding{Line: n.Line, Column: n.Column, Path: path, Msg: fmt.Sprintf("%s value out of range", path)}, false
+	}
+	return Finding{}, true
+}
+
+// unknownFieldsRule flags fields in spec that the Pod schema doesn't know
+// about. Off by default; enable it via Options.Rules or a .yamlvalidrc.
+type unknownFieldsRule struct{}
+
+func (unknownFieldsRule) ID() string                { return "unknown-fields" }
+func (unknownFieldsRule) DefaultSeverity() Severity { return SeverityOff }
+
+func (unknownFieldsRule) Check(file string, spec *yaml.Node) []Finding {
+	errs := evalSchema(file, podSpecDefs, podSpecSchema, spec, "spec", evalOpts{forbidUnknownFields: true})
+	var findings []Finding
+	for _, e := range errs {
+		if !strings.HasPrefix(e.Msg, "unknown field") {
+			continue
+		}
+		findings = append(findings, Finding{Line: e.Line, Column: e.Column, Path: e.Path, Msg: e.Msg})
+	}
+	return findings
+}
+
+// forEachContainer runs check over every well-formed entry of
+// spec.containers, collecting its findings.
+func forEachContainer(spec *yaml.Node, check func(idx int, c *yaml.Node, path string) []Finding) []Finding {
+	containers := get(spec, "containers")
+	if containers == nil || containers.Kind != yaml.SequenceNode {
+		return nil
+	}
+
+	var findings []Finding
+	for idx, c := range containers.Content {
+		if c.Kind != yaml.MappingNode {
+			continue
+		}
+		findings = append(findings, check(idx, c, fmt.Sprintf("spec.containers[%d]", idx))...)
+	}
+	return findings
+}
@@ -0,0 +1,195 @@
+package validate
+
+import "testing"
+
+func TestRunRules(t *testing.T) {
+	const validSpec = `
+containers:
+- name: app
+  image: registry.bigbrother.io/app:v1
+  resources:
+    requests:
+      memory: 128Mi
+`
+
+	tests := []struct {
+		name    string
+		spec    string
+		opts    Options
+		wantIDs []string // RuleIDs expected to fire, in order
+	}{
+		{
+			name: "valid spec produces nothing",
+			spec: validSpec,
+		},
+		{
+			name: "bad image registry",
+			spec: `
+containers:
+- name: app
+  image: notvalid
+  resources:
+    requests:
+      memory: 128Mi
+`,
+			wantIDs: []string{"image-registry-prefix"},
+		},
+		{
+			name: "bad container name",
+			spec: `
+containers:
+- name: BadName
+  image: registry.bigbrother.io/app:v1
+  resources:
+    requests:
+      memory: 128Mi
+`,
+			wantIDs: []string{"container-name-format"},
+		},
+		{
+			name: "bad memory suffix",
+			spec: `
+containers:
+- name: app
+  image: registry.bigbrother.io/app:v1
+  resources:
+    requests:
+      memory: "128"
+`,
+			wantIDs: []string{"memory-suffix"},
+		},
+		{
+			name: "port out of range",
+			spec: `
+containers:
+- name: app
+  image: registry.bigbrother.io/app:v1
+  resources:
+    requests:
+      memory: 128Mi
+  ports:
+  - containerPort: 99999
+`,
+			wantIDs: []string{"port-range"},
+		},
+		{
+			name: "rule disabled via Options.Rules",
+			spec: `
+containers:
+- name: app
+  image: notvalid
+  resources:
+    requests:
+      memory: 128Mi
+`,
+			opts: Options{Rules: map[string]Severity{"image-registry-prefix": SeverityOff}},
+		},
+		{
+			// Regression for a --strict false positive: unknown-fields must
+			// stay off unless Strict (or an explicit Rules entry) says so,
+			// even when the spec has a typo'd field.
+			name: "unknown-fields stays off by default even with a typo",
+			spec: `
+contianers:
+- name: app
+  image: registry.bigbrother.io/app:v1
+`,
+		},
+		{
+			name: "unknown-fields under --strict catches a typo",
+			spec: `
+contianers:
+- name: app
+  image: registry.bigbrother.io/app:v1
+`,
+			opts:    Options{Strict: true},
+			wantIDs: []string{"unknown-fields"},
+		},
+		{
+			// Regression: --strict must not reject a correctly-spelled
+			// PodSpec field the schema simply hasn't bothered to enumerate.
+			name: "unknown-fields under --strict leaves a known field alone",
+			spec: `
+restartPolicy: Always
+containers:
+- name: app
+  image: registry.bigbrother.io/app:v1
+  resources:
+    requests:
+      memory: 128Mi
+`,
+			opts: Options{Strict: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := parseNode(t, tt.spec)
+			errs := runRules("f", spec, tt.opts)
+			if len(errs) != len(tt.wantIDs) {
+				t.Fatalf("got %d errors, want %d: %+v", len(errs), len(tt.wantIDs), errs)
+			}
+			for i, wantID := range tt.wantIDs {
+				if errs[i].RuleID != wantID {
+					t.Errorf("error %d RuleID = %q, want %q", i, errs[i].RuleID, wantID)
+				}
+			}
+		})
+	}
+}
+
+func TestOptionsSeverityFor(t *testing.T) {
+	tests := []struct {
+		name         string
+		opts         Options
+		rule         Rule
+		wantSeverity Severity
+		wantEnabled  bool
+	}{
+		{
+			name:         "default severity when unconfigured",
+			opts:         Options{},
+			rule:         imageRegistryPrefixRule{},
+			wantSeverity: SeverityError,
+			wantEnabled:  true,
+		},
+		{
+			name:        "off-by-default rule stays disabled",
+			opts:        Options{},
+			rule:        unknownFieldsRule{},
+			wantEnabled: false,
+		},
+		{
+			name:         "strict turns unknown-fields on",
+			opts:         Options{Strict: true},
+			rule:         unknownFieldsRule{},
+			wantSeverity: SeverityError,
+			wantEnabled:  true,
+		},
+		{
+			name:        "explicit rc config overrides strict",
+			opts:        Options{Strict: true, Rules: map[string]Severity{"unknown-fields": SeverityOff}},
+			rule:        unknownFieldsRule{},
+			wantEnabled: false,
+		},
+		{
+			name:         "explicit rc config downgrades to warning",
+			opts:         Options{Rules: map[string]Severity{"image-registry-prefix": SeverityWarning}},
+			rule:         imageRegistryPrefixRule{},
+			wantSeverity: SeverityWarning,
+			wantEnabled:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			severity, enabled := tt.opts.severityFor(tt.rule)
+			if enabled != tt.wantEnabled {
+				t.Fatalf("enabled = %v, want %v", enabled, tt.wantEnabled)
+			}
+			if enabled && severity != tt.wantSeverity {
+				t.Errorf("severity = %v, want %v", severity, tt.wantSeverity)
+			}
+		})
+	}
+}
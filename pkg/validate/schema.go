@@ -0,0 +1,269 @@
+package validate
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// jsonSchema is a single (already JSON-decoded) OpenAPI/JSON-Schema node.
+// We walk it generically rather than binding it to a Go struct so that
+// adding a new kind is "drop in a schema file", not "write new Go".
+type jsonSchema map[string]interface{}
+
+type definitions map[string]jsonSchema
+
+// evalOpts carries engine-wide switches that aren't part of the schema
+// document itself.
+type evalOpts struct {
+	// forbidUnknownFields, when set, treats every object schema that lists
+	// "properties" and says nothing about additionalProperties as if its
+	// additionalProperties were false (see evalObject). Used by the unknown-fields rule so the check can be toggled without
+	// editing the embedded schema.
+	forbidUnknownFields bool
+}
+
+// mustLoadSchema parses an embedded schema document and splits out its
+// top-level "definitions" so $ref lookups don't have to re-walk the tree.
+func mustLoadSchema(raw []byte) (root jsonSchema, defs definitions) {
+	var doc map[string]interface{}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		panic(fmt.Sprintf("validate: invalid embedded schema: %v", err))
+	}
+
+	defs = definitions{}
+	if rawDefs, ok := doc["definitions"].(map[string]interface{}); ok {
+		for name, v := range rawDefs {
+			if m, ok := v.(map[string]interface{}); ok {
+				defs[name] = jsonSchema(m)
+			}
+		}
+	}
+	return jsonSchema(doc), defs
+}
+
+// evalSchema walks node alongside schema, resolving $ref/oneOf/properties/
+// items against defs, and reports every mismatch as an Error positioned at
+// the offending yaml.Node's line.
+func evalSchema(file string, defs definitions, schema jsonSchema, node *yaml.Node, path string, opts evalOpts) []Error {
+	if ref, ok := schema["$ref"].(string); ok {
+		resolved, ok := defs[strings.TrimPrefix(ref, "#/definitions/")]
+		if !ok {
+			return []Error{ve(file, node, path, fmt.Sprintf("%s: unknown schema reference '%s'", path, ref))}
+		}
+		return evalSchema(file, defs, resolved, node, path, opts)
+	}
+
+	if alternatives, ok := schema["oneOf"].([]interface{}); ok {
+		return evalOneOf(file, defs, alternatives, node, path, opts)
+	}
+
+	var errs []Error
+
+	if wantType, ok := schema["type"].(string); ok {
+		if !nodeMatchesType(node, wantType) {
+			return []Error{ve(file, node, path, fmt.Sprintf("%s must be %s", path, schemaTypeLabel(wantType)))}
+		}
+	}
+
+	if enum, ok := schema["enum"].([]interface{}); ok && node.Kind == yaml.ScalarNode {
+		if !enumContains(enum, node.Value) {
+			errs = append(errs, ve(file, node, path, fmt.Sprintf("%s has unsupported value '%s'", path, node.Value)))
+		}
+	}
+
+	if pattern, ok := schema["pattern"].(string); ok && node.Kind == yaml.ScalarNode {
+		if re, err := regexp.Compile(pattern); err == nil && !re.MatchString(node.Value) {
+			errs = append(errs, ve(file, node, path, fmt.Sprintf("%s has invalid format '%s'", path, node.Value)))
+		}
+	}
+
+	if node.Kind == yaml.MappingNode {
+		errs = append(errs, evalObject(file, defs, schema, node, path, opts)...)
+	}
+	if node.Kind == yaml.SequenceNode {
+		errs = append(errs, evalArray(file, defs, schema, node, path, opts)...)
+	}
+
+	return errs
+}
+
+func evalObject(file string, defs definitions, schema jsonSchema, node *yaml.Node, path string, opts evalOpts) []Error {
+	var errs []Error
+
+	props, hasProps := schema["properties"].(map[string]interface{})
+	explicitAdditional, hasExplicitAdditional := schema["additionalProperties"].(bool)
+
+	additionalAllowed := true
+	if hasExplicitAdditional {
+		additionalAllowed = explicitAdditional
+	}
+	// forbidUnknownFields only closes objects the schema actually bothered
+	// to enumerate ("properties" present) and that didn't already say
+	// additionalProperties explicitly (an explicit true, e.g. a free-form
+	// map like nodeSelector, means the author intends it open even in
+	// strict mode). An object schema with no "properties" at all (also a
+	// free-form map) is left alone entirely.
+	if opts.forbidUnknownFields && hasProps && !hasExplicitAdditional {
+		additionalAllowed = false
+	}
+
+	seen := map[string]bool{}
+	for i := 0; i < len(node.Content); i += 2 {
+		k := node.Content[i]
+		v := node.Content[i+1]
+		seen[k.Value] = true
+
+		if propRaw, ok := props[k.Value]; ok {
+			if propSchema, ok := propRaw.(map[string]interface{}); ok {
+				errs = append(errs, evalSchema(file, defs, jsonSchema(propSchema), v, path+"."+k.Value, opts)...)
+			}
+			continue
+		}
+		if !additionalAllowed {
+			msg := fmt.Sprintf("unknown field '%s' at %s", k.Value, path)
+			if suggestion, ok := nearestKey(k.Value, props); ok {
+				msg = fmt.Sprintf("%s, did you mean '%s'?", msg, suggestion)
+			}
+			errs = append(errs, ve(file, k, path, msg))
+		}
+	}
+
+	if required, ok := schema["required"].([]interface{}); ok {
+		for _, r := range required {
+			name, _ := r.(string)
+			if !seen[name] {
+				errs = append(errs, ve(file, nil, path+"."+name, fmt.Sprintf("%s.%s is required", path, name)))
+			}
+		}
+	}
+
+	return errs
+}
+
+func evalArray(file string, defs definitions, schema jsonSchema, node *yaml.Node, path string, opts evalOpts) []Error {
+	var errs []Error
+
+	if minItems, ok := schema["minItems"].(float64); ok && float64(len(node.Content)) < minItems {
+		errs = append(errs, ve(file, node, path, fmt.Sprintf("%s is required", path)))
+	}
+
+	itemsRaw, ok := schema["items"].(map[string]interface{})
+	if !ok {
+		return errs
+	}
+	itemSchema := jsonSchema(itemsRaw)
+	for i, item := range node.Content {
+		errs = append(errs, evalSchema(file, defs, itemSchema, item, fmt.Sprintf("%s[%d]", path, i), opts)...)
+	}
+	return errs
+}
+
+func evalOneOf(file string, defs definitions, alternatives []interface{}, node *yaml.Node, path string, opts evalOpts) []Error {
+	for _, alt := range alternatives {
+		altSchema, ok := alt.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if len(evalSchema(file, defs, jsonSchema(altSchema), node, path, opts)) == 0 {
+			return nil
+		}
+	}
+	return []Error{ve(file, node, path, fmt.Sprintf("%s does not match any allowed schema", path))}
+}
+
+func nodeMatchesType(n *yaml.Node, t string) bool {
+	switch t {
+	case "object":
+		return n.Kind == yaml.MappingNode
+	case "array":
+		return n.Kind == yaml.SequenceNode
+	case "string":
+		return n.Kind == yaml.ScalarNode && n.Tag == "!!str"
+	case "integer":
+		if n.Kind != yaml.ScalarNode {
+			return false
+		}
+		_, err := strconv.Atoi(strings.TrimSpace(n.Value))
+		return err == nil
+	case "boolean":
+		return n.Kind == yaml.ScalarNode && n.Tag == "!!bool"
+	default:
+		return true
+	}
+}
+
+func schemaTypeLabel(t string) string {
+	if t == "integer" {
+		return "int"
+	}
+	return t
+}
+
+// maxSuggestEditDistance bounds how far off a misspelled field can be from
+// a known one before we stop suggesting it: beyond this it's more likely a
+// genuinely different (if unsupported) field than a typo.
+const maxSuggestEditDistance = 2
+
+// nearestKey finds the key in props closest to got by edit distance,
+// within maxSuggestEditDistance, e.g. "contianers" -> "containers".
+func nearestKey(got string, props map[string]interface{}) (string, bool) {
+	best := ""
+	bestDist := maxSuggestEditDistance + 1
+	for candidate := range props {
+		d := levenshtein(got, candidate)
+		if d < bestDist {
+			best, bestDist = candidate, d
+		}
+	}
+	if bestDist > maxSuggestEditDistance {
+		return "", false
+	}
+	return best, true
+}
+
+// levenshtein returns the classic single-character insert/delete/substitute
+// edit distance between a and b.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min3(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
+
+func enumContains(enum []interface{}, value string) bool {
+	for _, e := range enum {
+		if s, ok := e.(string); ok && s == value {
+			return true
+		}
+	}
+	return false
+}
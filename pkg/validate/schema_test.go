@@ -0,0 +1,182 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+// parseNode unmarshals src and returns the document's top-level node, the
+// way every validator in this package expects to receive it (a MappingNode
+// or SequenceNode, not the wrapping DocumentNode).
+func parseNode(t *testing.T, src string) *yaml.Node {
+	t.Helper()
+	var n yaml.Node
+	if err := yaml.Unmarshal([]byte(src), &n); err != nil {
+		t.Fatalf("yaml.Unmarshal: %v", err)
+	}
+	if n.Kind == yaml.DocumentNode && len(n.Content) > 0 {
+		return n.Content[0]
+	}
+	return &n
+}
+
+func TestEvalSchema(t *testing.T) {
+	schema := jsonSchema{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"name": map[string]interface{}{"type": "string"},
+			"port": map[string]interface{}{"type": "integer"},
+		},
+		"required": []interface{}{"name"},
+	}
+	defs := definitions{}
+
+	tests := []struct {
+		name    string
+		src     string
+		opts    evalOpts
+		wantMsg []string // substrings expected in errs, in order
+	}{
+		{
+			name: "valid object",
+			src:  "name: app\nport: 8080\n",
+		},
+		{
+			name:    "missing required field",
+			src:     "port: 8080\n",
+			wantMsg: []string{"x.name is required"},
+		},
+		{
+			name:    "wrong type",
+			src:     "name: app\nport: not-a-number\n",
+			wantMsg: []string{"x.port must be int"},
+		},
+		{
+			name: "unknown field allowed by default",
+			src:  "name: app\nextra: true\n",
+		},
+		{
+			name:    "unknown field forbidden when forbidUnknownFields is set",
+			src:     "name: app\nextra: true\n",
+			opts:    evalOpts{forbidUnknownFields: true},
+			wantMsg: []string{"unknown field 'extra' at x"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := parseNode(t, tt.src)
+			errs := evalSchema("f", defs, schema, node, "x", tt.opts)
+			if len(errs) != len(tt.wantMsg) {
+				t.Fatalf("got %d errors, want %d: %+v", len(errs), len(tt.wantMsg), errs)
+			}
+			for i, want := range tt.wantMsg {
+				if !strings.Contains(errs[i].Msg, want) {
+					t.Errorf("error %d = %q, want substring %q", i, errs[i].Msg, want)
+				}
+			}
+		})
+	}
+}
+
+func TestEvalSchemaRefAndOneOf(t *testing.T) {
+	defs := definitions{
+		"Widget": jsonSchema{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"id": map[string]interface{}{"type": "string"},
+			},
+			"required": []interface{}{"id"},
+		},
+	}
+	refSchema := jsonSchema{"$ref": "#/definitions/Widget"}
+	oneOfSchema := jsonSchema{
+		"oneOf": []interface{}{
+			map[string]interface{}{"type": "string", "enum": []interface{}{"linux", "windows"}},
+			map[string]interface{}{"$ref": "#/definitions/Widget"},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		schema  jsonSchema
+		src     string
+		wantErr bool
+	}{
+		{name: "$ref resolves and validates", schema: refSchema, src: "id: w1\n"},
+		{name: "$ref propagates nested errors", schema: refSchema, src: "{}\n", wantErr: true},
+		{name: "oneOf matches first alternative", schema: oneOfSchema, src: "linux\n"},
+		{name: "oneOf matches second alternative", schema: oneOfSchema, src: "id: w1\n"},
+		{name: "oneOf matches neither alternative", schema: oneOfSchema, src: "macos\n", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := parseNode(t, tt.src)
+			errs := evalSchema("f", defs, tt.schema, node, "x", evalOpts{})
+			if got := len(errs) > 0; got != tt.wantErr {
+				t.Fatalf("got errs=%v (wantErr=%v): %+v", got, tt.wantErr, errs)
+			}
+		})
+	}
+}
+
+func TestEvalArrayMinItems(t *testing.T) {
+	schema := jsonSchema{
+		"type":     "array",
+		"minItems": float64(1),
+		"items":    map[string]interface{}{"type": "string"},
+	}
+	defs := definitions{}
+
+	tests := []struct {
+		name    string
+		src     string
+		wantErr bool
+	}{
+		{name: "empty array violates minItems", src: "[]\n", wantErr: true},
+		{name: "non-empty array satisfies minItems", src: "[a, b]\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := parseNode(t, tt.src)
+			errs := evalSchema("f", defs, schema, node, "x", evalOpts{})
+			if got := len(errs) > 0; got != tt.wantErr {
+				t.Fatalf("got errs=%v (wantErr=%v): %+v", got, tt.wantErr, errs)
+			}
+		})
+	}
+}
+
+func TestNearestKey(t *testing.T) {
+	props := map[string]interface{}{
+		"containers":    nil,
+		"restartPolicy": nil,
+		"volumes":       nil,
+	}
+
+	tests := []struct {
+		got         string
+		wantFound   bool
+		wantSuggest string
+	}{
+		{got: "contianers", wantFound: true, wantSuggest: "containers"},
+		{got: "restartPolcy", wantFound: true, wantSuggest: "restartPolicy"},
+		{got: "totallyUnrelatedField", wantFound: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.got, func(t *testing.T) {
+			suggestion, ok := nearestKey(tt.got, props)
+			if ok != tt.wantFound {
+				t.Fatalf("nearestKey(%q) found = %v, want %v", tt.got, ok, tt.wantFound)
+			}
+			if ok && suggestion != tt.wantSuggest {
+				t.Errorf("nearestKey(%q) = %q, want %q", tt.got, suggestion, tt.wantSuggest)
+			}
+		})
+	}
+}
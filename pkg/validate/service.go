@@ -0,0 +1,81 @@
+package validate
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+func validateServiceObject(file string, doc *yaml.Node, opts Options) []Error {
+	var errs []Error
+
+	errs = append(errs, requireMeta(file, doc, opts)...)
+
+	spec := mustGet(doc, "spec")
+	if spec == nil {
+		errs = append(errs, ve(file, nil, "spec", "spec is required"))
+		return errs
+	}
+	errs = append(errs, validateServiceSpec(file, spec, opts)...)
+	return errs
+}
+
+func validateServiceSpec(file string, n *yaml.Node, opts Options) []Error {
+	if n.Kind != yaml.MappingNode {
+		return []Error{ve(file, n, "spec", "spec must be object")}
+	}
+	var errs []Error
+
+	if selector := get(n, "selector"); selector != nil {
+		errs = append(errs, validateStringMap(file, selector, "spec.selector")...)
+	}
+
+	ports := mustGet(n, "ports")
+	if ports == nil {
+		errs = append(errs, ve(file, nil, "spec.ports", "spec.ports is required"))
+		return errs
+	}
+	errs = append(errs, validateServicePorts(file, ports)...)
+
+	errs = append(errs, checkUnknownFields(file, n, "spec", []string{"selector", "ports"}, opts)...)
+
+	return errs
+}
+
+func validateServicePorts(file string, n *yaml.Node) []Error {
+	if n.Kind != yaml.SequenceNode {
+		return []Error{ve(file, n, "spec.ports", "spec.ports must be array")}
+	}
+	if len(n.Content) == 0 {
+		return []Error{ve(file, n, "spec.ports", "spec.ports is required")}
+	}
+
+	var errs []Error
+	for i, p := range n.Content {
+		path := fmt.Sprintf("spec.ports[%d]", i)
+		if p.Kind != yaml.MappingNode {
+			errs = append(errs, ve(file, p, path, path+" must be object"))
+			continue
+		}
+
+		port := mustGet(p, "port")
+		if port == nil {
+			errs = append(errs, ve(file, nil, path+".port", "port is required"))
+		} else {
+			errs = append(errs, validatePortInt(file, port, path+".port", "port")...)
+		}
+
+		if targetPort := get(p, "targetPort"); targetPort != nil {
+			errs = append(errs, validatePortInt(file, targetPort, path+".targetPort", "targetPort")...)
+		}
+
+		if proto := get(p, "protocol"); proto != nil {
+			if proto.Kind != yaml.ScalarNode || proto.Tag != "!!str" {
+				errs = append(errs, ve(file, proto, path+".protocol", "protocol must be string"))
+			} else if proto.Value != "TCP" && proto.Value != "UDP" {
+				errs = append(errs, ve(file, proto, path+".protocol", fmt.Sprintf("protocol has unsupported value '%s'", proto.Value)))
+			}
+		}
+	}
+	return errs
+}
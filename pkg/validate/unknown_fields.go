@@ -0,0 +1,47 @@
+package validate
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// checkUnknownFields reports every key of n that isn't in known, the same
+// way unknownFieldsRule does for a Pod spec, but for the hand-rolled
+// Deployment/Service/ConfigMap/Job/CronJob/ObjectMeta validators that have
+// no JSON schema to walk. It's gated behind the same "unknown-fields" rule
+// (and Options.Strict) as the Pod-spec check, so it stays opt-in everywhere
+// rather than just under spec.
+func checkUnknownFields(file string, n *yaml.Node, path string, known []string, opts Options) []Error {
+	severity, enabled := opts.severityFor(unknownFieldsRule{})
+	if !enabled || n == nil || n.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	props := make(map[string]interface{}, len(known))
+	for _, k := range known {
+		props[k] = nil
+	}
+
+	var errs []Error
+	for i := 0; i < len(n.Content); i += 2 {
+		k := n.Content[i]
+		if _, ok := props[k.Value]; ok {
+			continue
+		}
+		msg := fmt.Sprintf("unknown field '%s' at %s", k.Value, path)
+		if suggest, ok := nearestKey(k.Value, props); ok {
+			msg = fmt.Sprintf("%s, did you mean '%s'?", msg, suggest)
+		}
+		errs = append(errs, Error{
+			File:     file,
+			Line:     k.Line,
+			Column:   k.Column,
+			Path:     path,
+			RuleID:   unknownFieldsRule{}.ID(),
+			Severity: severity,
+			Msg:      msg,
+		})
+	}
+	return errs
+}
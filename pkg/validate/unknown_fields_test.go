@@ -0,0 +1,91 @@
+package validate
+
+import "testing"
+
+func TestCheckUnknownFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     string
+		known   []string
+		opts    Options
+		wantMsg string // substring, empty means no error expected
+	}{
+		{
+			name:  "known field passes",
+			src:   "replicas: 2\n",
+			known: []string{"replicas", "selector", "template"},
+		},
+		{
+			name:  "typo stays off by default even under a typo",
+			src:   "replicaz: 2\n",
+			known: []string{"replicas", "selector", "template"},
+		},
+		{
+			name:    "typo caught under --strict with a suggestion",
+			src:     "replicaz: 2\n",
+			known:   []string{"replicas", "selector", "template"},
+			opts:    Options{Strict: true},
+			wantMsg: "unknown field 'replicaz' at spec, did you mean 'replicas'?",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := parseNode(t, tt.src)
+			errs := checkUnknownFields("f", n, "spec", tt.known, tt.opts)
+			if tt.wantMsg == "" {
+				if len(errs) != 0 {
+					t.Fatalf("got %d errors, want 0: %+v", len(errs), errs)
+				}
+				return
+			}
+			if len(errs) != 1 {
+				t.Fatalf("got %d errors, want 1: %+v", len(errs), errs)
+			}
+			if errs[0].Msg != tt.wantMsg {
+				t.Errorf("Msg = %q, want %q", errs[0].Msg, tt.wantMsg)
+			}
+			if errs[0].RuleID != "unknown-fields" {
+				t.Errorf("RuleID = %q, want %q", errs[0].RuleID, "unknown-fields")
+			}
+		})
+	}
+}
+
+func TestValidateDeploymentObjectCatchesSpecTypo(t *testing.T) {
+	const src = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+spec:
+  replicaz: 2
+  selector:
+    matchLabels:
+      app: app
+  template:
+    spec:
+      containers:
+      - name: app
+        image: registry.bigbrother.io/app:v1
+        resources:
+          requests:
+            memory: 128Mi
+`
+	doc := parseNode(t, src)
+
+	if errs := validateDeploymentObject("f", doc, Options{}); len(errs) != 0 {
+		t.Fatalf("without --strict: got %d errors, want 0: %+v", len(errs), errs)
+	}
+
+	errs := validateDeploymentObject("f", doc, Options{Strict: true})
+	var found bool
+	for _, e := range errs {
+		if e.RuleID == "unknown-fields" && e.Path == "spec" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("with --strict: expected an unknown-fields error on spec, got %+v", errs)
+	}
+}
@@ -0,0 +1,159 @@
+// Package validate implements structural validation of Kubernetes
+// manifests of the kinds in its registry (Pod, Deployment, Service,
+// ConfigMap, Job, CronJob). It is built to be embedded by other Go programs (an admission
+// webhook, a CI tool) rather than shelled out to as a CLI.
+package validate
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Severity indicates how serious a finding is.
+type Severity int
+
+const (
+	// SeverityError is a finding that should fail validation. It is the
+	// zero value so an Error built without setting Severity still reads as
+	// an error, the way this package has always behaved.
+	SeverityError Severity = iota
+	// SeverityWarning is a finding worth surfacing but not failing on.
+	SeverityWarning
+	// SeverityOff is only meaningful as an Options.Rules value: it turns a
+	// rule off entirely. A Finding is never reported at this severity.
+	SeverityOff
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityWarning:
+		return "warning"
+	case SeverityOff:
+		return "off"
+	default:
+		return "error"
+	}
+}
+
+// Options configures the pluggable rules (see Rule) that run alongside the
+// embedded OpenAPI schema. The zero value runs every registered rule at its
+// own DefaultSeverity.
+type Options struct {
+	// Rules maps a Rule's ID to the severity it should run at. A rule
+	// mapped to SeverityOff is skipped; a rule absent from this map runs
+	// at its own DefaultSeverity.
+	Rules map[string]Severity
+
+	// Strict turns on the "unknown-fields" rule (off by default) unless
+	// Rules already has an explicit entry for it, mirroring
+	// `kubectl --validate=strict`.
+	Strict bool
+}
+
+func (o Options) severityFor(r Rule) (severity Severity, enabled bool) {
+	if configured, ok := o.Rules[r.ID()]; ok {
+		return configured, configured != SeverityOff
+	}
+	if o.Strict && r.ID() == "unknown-fields" {
+		return SeverityError, true
+	}
+	def := r.DefaultSeverity()
+	return def, def != SeverityOff
+}
+
+// Error is a single validation finding, with enough positional information
+// for a caller to point a user (or a PR annotation) at the offending node.
+type Error struct {
+	File     string
+	Line     int    // 0 => line unknown / not required
+	Column   int    // 0 => column unknown / not required; unset whenever Line is
+	Path     string // JSONPath-like, e.g. "spec.containers[0].image"
+	RuleID   string // e.g. "image-registry-prefix"; "schema" for schema-driven findings
+	Doc      int    // 1-based index of the document within a multi-document stream, 0 if N/A
+	Severity Severity
+	Msg      string
+}
+
+func (e Error) Error() string { return e.Msg }
+
+// Validate runs validation against a single already-parsed YAML document
+// (as produced by yaml.Unmarshal, or by decoding one document out of a
+// multi-document stream), dispatching on its apiVersion and kind.
+func Validate(node *yaml.Node) []Error {
+	return ValidateWithOptions(node, Options{})
+}
+
+// ValidateWithOptions is Validate with explicit control over which
+// pluggable rules run and at what severity.
+func ValidateWithOptions(node *yaml.Node, opts Options) []Error {
+	doc := firstDocument(node)
+	if doc == nil {
+		return []Error{{Msg: "cannot unmarshal file content: empty document"}}
+	}
+	return validateDocument("", doc, opts)
+}
+
+// ValidateBytes parses content as a (possibly multi-document, `---`
+// separated) YAML stream and validates each document. Every error carries
+// the 1-based Doc index of the document it came from, so callers can tell
+// them apart.
+func ValidateBytes(content []byte) []Error {
+	return ValidateBytesWithOptions(content, Options{})
+}
+
+// ValidateBytesWithOptions is ValidateBytes with explicit control over
+// which pluggable rules run and at what severity.
+func ValidateBytesWithOptions(content []byte, opts Options) []Error {
+	dec := yaml.NewDecoder(bytes.NewReader(content))
+
+	var errs []Error
+	docIdx := 0
+	for {
+		var raw yaml.Node
+		err := dec.Decode(&raw)
+		if err == io.EOF {
+			break
+		}
+		docIdx++
+		if err != nil {
+			errs = append(errs, Error{Doc: docIdx, Msg: fmt.Sprintf("cannot unmarshal file content: %v", err)})
+			break
+		}
+
+		docErrs := ValidateWithOptions(&raw, opts)
+		for i := range docErrs {
+			docErrs[i].Doc = docIdx
+		}
+		errs = append(errs, docErrs...)
+	}
+
+	if docIdx == 0 {
+		return []Error{{Msg: "cannot unmarshal file content: empty document"}}
+	}
+	return errs
+}
+
+// ValidateFile reads path and validates it as one or more manifests.
+func ValidateFile(path string) []Error {
+	return ValidateFileWithOptions(path, Options{})
+}
+
+// ValidateFileWithOptions is ValidateFile with explicit control over which
+// pluggable rules run and at what severity.
+func ValidateFileWithOptions(path string, opts Options) []Error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return []Error{{File: filepath.Clean(path), Msg: fmt.Sprintf("cannot read file content: %v", err)}}
+	}
+
+	errs := ValidateBytesWithOptions(content, opts)
+	for i := range errs {
+		errs[i].File = filepath.Clean(path)
+	}
+	return errs
+}